@@ -0,0 +1,54 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+// ControlPlaneConfig contains configuration settings for the control plane.
+type ControlPlaneConfig struct {
+	// LoadBalancer contains configuration for the AWS load balancer fronting the shoot's
+	// API server and ingress.
+	// +optional
+	LoadBalancer *LoadBalancer
+	// Storage contains configuration for the storage in the cluster.
+	// +optional
+	Storage *Storage
+	// EKSClusterName overrides the name of the EKS cluster resource managed for this shoot.
+	// +optional
+	EKSClusterName *string
+}
+
+// LoadBalancer contains configuration for an AWS ELB/NLB.
+type LoadBalancer struct {
+	// Hostname is the externally reachable DNS hostname assigned to the load balancer,
+	// e.g. an ELB CNAME such as "*.ingress.foo.example.com".
+	// +optional
+	Hostname *string
+	// Name overrides the name Gardener assigns to the load balancer resource.
+	// +optional
+	Name *string
+}
+
+// Storage contains configuration for the storage in the cluster.
+type Storage struct {
+	// CSIManagedResources are the resources managed by the CSI driver.
+	// +optional
+	CSIManagedResources *CSIManagedResources
+}
+
+// CSIManagedResources are the resources managed by the CSI driver.
+type CSIManagedResources struct {
+	// Disabled indicates whether the management of these resources is disabled.
+	// +optional
+	Disabled bool
+}
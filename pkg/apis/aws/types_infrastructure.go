@@ -0,0 +1,118 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+// InfrastructureConfig infrastructure configuration resource
+type InfrastructureConfig struct {
+	// EnableECRAccess specifies whether the IAM role policy for the worker nodes shall
+	// contain permissions to access ECR.
+	// +optional
+	EnableECRAccess *bool
+	// Networks is the AWS specific network configuration
+	Networks Networks
+	// IgnoreTags instructs Gardener to ignore certain tags/labels when syncing resource tags.
+	// +optional
+	IgnoreTags *IgnoreTags
+	// BucketName overrides the name of the S3 bucket Gardener creates for this shoot's
+	// infrastructure state.
+	// +optional
+	BucketName *string
+	// EKS contains EKS-specific infrastructure settings.
+	// +optional
+	EKS *EKS
+}
+
+// EKS contains EKS-specific infrastructure settings.
+type EKS struct {
+	// SubnetIDs are the IDs of existing subnets to use for the EKS cluster's control plane
+	// elastic network interfaces.
+	// +optional
+	SubnetIDs []string
+}
+
+// Networks holds information about the Kubernetes and infrastructure networks.
+type Networks struct {
+	// VPC indicates whether to use an existing VPC or create a new one.
+	VPC VPC
+	// Zones belonging to the same region
+	Zones []Zone
+	// DNS contains the hostnames Gardener manages for this shoot's infrastructure,
+	// e.g. the Route53 hosted zone backing the cluster's public ingress.
+	// +optional
+	DNS *DNS
+	// NATGateways contains the NAT gateway configurations used by the zones' workers subnets.
+	// +optional
+	NATGateways []NATGateway
+}
+
+// NATGateway contains the configuration for a NAT gateway in a zone.
+type NATGateway struct {
+	// Zone is the name of the zone this NAT gateway is created in.
+	Zone string
+	// ElasticIPAllocationID is the allocation ID of an existing elastic IP to attach to the NAT
+	// gateway. If empty, Gardener allocates a new elastic IP.
+	// +optional
+	ElasticIPAllocationID *string
+}
+
+// VPC contains information about the AWS VPC and some related resources.
+type VPC struct {
+	// ID is the VPC id.
+	// +optional
+	ID *string
+	// CIDR is the VPC CIDR.
+	// +optional
+	CIDR *string
+	// CIDRBlocks lists additional CIDR blocks associated with the VPC, e.g. for secondary IP
+	// ranges.
+	// +optional
+	CIDRBlocks []string
+	// SecurityGroupName overrides the name Gardener assigns to the shoot's default security group.
+	// +optional
+	SecurityGroupName *string
+}
+
+// Zone describes the properties of a zone.
+type Zone struct {
+	// Name is the name for this zone.
+	Name string
+	// Internal is the private subnet range to create (used for internal load balancers).
+	Internal string
+	// Public is the public subnet range to create (used for bastion and load balancers).
+	Public string
+	// Workers is the workers subnet range to create (used for the VMs).
+	Workers string
+}
+
+// DNS contains the hostnames managed for this shoot's infrastructure.
+type DNS struct {
+	// HostedZoneDomain is the domain of the Route53 hosted zone that backs the shoot's
+	// ingress, e.g. "*.ingress.foo.example.com" for wildcard ingress records.
+	// +optional
+	HostedZoneDomain *string
+	// HostedZoneName is the name of the Route53 hosted zone resource.
+	// +optional
+	HostedZoneName *string
+}
+
+// IgnoreTags holds information about ignored resource tags.
+type IgnoreTags struct {
+	// Keys is a list of individual tag keys to ignore.
+	// +optional
+	Keys []string
+	// KeyPrefixes is a list of tag key prefixes to ignore.
+	// +optional
+	KeyPrefixes []string
+}
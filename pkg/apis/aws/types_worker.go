@@ -0,0 +1,64 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import "k8s.io/apimachinery/pkg/util/intstr"
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	// IAMInstanceProfile contains configuration settings for the IAM instance profile
+	// attached to the worker nodes.
+	// +optional
+	IAMInstanceProfile *IAMInstanceProfile
+	// IngressHostnames are additional DNS hostnames (e.g. wildcard ingress records)
+	// that are allowed to route traffic to this worker pool's nodes.
+	// +optional
+	IngressHostnames []string
+	// Zones contains the per-zone distribution of the worker pool's rolling-update settings.
+	// It is only required when the pool-level MaxSurge/MaxUnavailable budget should be spread
+	// unevenly across zones. The sums over the listed zones must not exceed the pool-level values;
+	// what the listed zones leave of the pool-level budget is not assigned to any zone here.
+	// +optional
+	Zones []WorkerPoolZone
+}
+
+// WorkerPoolZone contains the rolling-update settings for a single zone of a worker pool.
+type WorkerPoolZone struct {
+	// Name is the zone name.
+	Name string
+	// Minimum is the minimum number of VMs to create in this zone.
+	// +optional
+	Minimum *int32
+	// Maximum is the maximum number of VMs to create in this zone.
+	// +optional
+	Maximum *int32
+	// MaxSurge is the maximum number of VMs that are surged in this zone during a rolling update.
+	// +optional
+	MaxSurge *intstr.IntOrString
+	// MaxUnavailable is the maximum number of VMs that can be unavailable in this zone during a
+	// rolling update.
+	// +optional
+	MaxUnavailable *intstr.IntOrString
+}
+
+// IAMInstanceProfile contains configuration settings for the IAM instance profile.
+type IAMInstanceProfile struct {
+	// Name references an existing IAM instance profile by name.
+	// +optional
+	Name *string
+	// ARN references an existing IAM instance profile by ARN.
+	// +optional
+	ARN *string
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
+func ValidateControlPlaneConfig(cp *apisaws.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cp.LoadBalancer != nil {
+		loadBalancerPath := fldPath.Child("loadBalancer")
+		if cp.LoadBalancer.Hostname != nil {
+			allErrs = append(allErrs, validateWildcardDNS1123Subdomain(*cp.LoadBalancer.Hostname, loadBalancerPath.Child("hostname"))...)
+		}
+		if cp.LoadBalancer.Name != nil {
+			allErrs = append(allErrs, validateResourceName(KindELB, *cp.LoadBalancer.Name, loadBalancerPath.Child("name"))...)
+		}
+	}
+
+	if cp.EKSClusterName != nil {
+		allErrs = append(allErrs, validateResourceName(KindEKSCluster, *cp.EKSClusterName, fldPath.Child("eksClusterName"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+// ValidateInfrastructureConfig validates an InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := fldPath.Child("networks")
+	if infra.Networks.DNS != nil {
+		dnsPath := networksPath.Child("dns")
+		if infra.Networks.DNS.HostedZoneDomain != nil {
+			allErrs = append(allErrs, validateWildcardDNS1123Subdomain(*infra.Networks.DNS.HostedZoneDomain, dnsPath.Child("hostedZoneDomain"))...)
+		}
+		if infra.Networks.DNS.HostedZoneName != nil {
+			allErrs = append(allErrs, validateResourceName(KindRoute53Zone, *infra.Networks.DNS.HostedZoneName, dnsPath.Child("hostedZoneName"))...)
+		}
+	}
+
+	if infra.Networks.VPC.SecurityGroupName != nil {
+		allErrs = append(allErrs, validateResourceName(KindSecurityGroup, *infra.Networks.VPC.SecurityGroupName, networksPath.Child("vpc", "securityGroupName"))...)
+	}
+
+	if infra.BucketName != nil {
+		allErrs = append(allErrs, validateResourceName(KindS3Bucket, *infra.BucketName, fldPath.Child("bucketName"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates that the immutable parts of an InfrastructureConfig
+// were not changed in a way that would require recreating the underlying AWS resources. Elements
+// of the zones, NAT gateway, VPC CIDR block, and EKS subnet ID lists may be added or reordered
+// freely, but an existing element must neither be removed nor mutated in place.
+func ValidateInfrastructureConfigUpdate(new, old *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := fldPath.Child("networks")
+
+	allErrs = append(allErrs, ValidateImmutableSet(new.Networks.Zones, old.Networks.Zones, func(z apisaws.Zone) string { return z.Name }, networksPath.Child("zones"))...)
+	allErrs = append(allErrs, ValidateImmutableSet(new.Networks.NATGateways, old.Networks.NATGateways, func(n apisaws.NATGateway) string { return n.Zone }, networksPath.Child("natGateways"))...)
+	allErrs = append(allErrs, ValidateImmutableSet(new.Networks.VPC.CIDRBlocks, old.Networks.VPC.CIDRBlocks, func(cidr string) string { return cidr }, networksPath.Child("vpc", "cidrBlocks"))...)
+
+	var newSubnetIDs, oldSubnetIDs []string
+	if new.EKS != nil {
+		newSubnetIDs = new.EKS.SubnetIDs
+	}
+	if old.EKS != nil {
+		oldSubnetIDs = old.EKS.SubnetIDs
+	}
+	allErrs = append(allErrs, ValidateImmutableSet(newSubnetIDs, oldSubnetIDs, func(id string) string { return id }, fldPath.Child("eks", "subnetIDs"))...)
+
+	return allErrs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+var _ = Describe("#ValidateInfrastructureConfigUpdate", func() {
+	var fldPath *field.Path
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("spec", "infrastructureConfig")
+	})
+
+	It("should allow renumbering zones as long as none are removed or mutated", func() {
+		old := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				Zones: []apisaws.Zone{
+					{Name: "eu-west-1a", Internal: "10.250.0.0/24", Public: "10.250.1.0/24", Workers: "10.250.2.0/24"},
+					{Name: "eu-west-1b", Internal: "10.250.3.0/24", Public: "10.250.4.0/24", Workers: "10.250.5.0/24"},
+				},
+			},
+		}
+		new := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				Zones: []apisaws.Zone{
+					old.Networks.Zones[1],
+					old.Networks.Zones[0],
+				},
+			},
+		}
+
+		Expect(ValidateInfrastructureConfigUpdate(new, old, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid swapping the elastic IPs between NAT gateways of two zones", func() {
+		eip1, eip2 := "eipalloc-1", "eipalloc-2"
+		old := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				NATGateways: []apisaws.NATGateway{
+					{Zone: "eu-west-1a", ElasticIPAllocationID: &eip1},
+					{Zone: "eu-west-1b", ElasticIPAllocationID: &eip2},
+				},
+			},
+		}
+		new := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				NATGateways: []apisaws.NATGateway{
+					{Zone: "eu-west-1a", ElasticIPAllocationID: &eip2},
+					{Zone: "eu-west-1b", ElasticIPAllocationID: &eip1},
+				},
+			},
+		}
+
+		Expect(ValidateInfrastructureConfigUpdate(new, old, fldPath)).To(HaveLen(2))
+	})
+
+	It("should forbid removing a zone", func() {
+		old := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				Zones: []apisaws.Zone{{Name: "eu-west-1a"}, {Name: "eu-west-1b"}},
+			},
+		}
+		new := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				Zones: []apisaws.Zone{{Name: "eu-west-1a"}},
+			},
+		}
+
+		Expect(ValidateInfrastructureConfigUpdate(new, old, fldPath)).NotTo(BeEmpty())
+	})
+
+	It("should allow adding VPC CIDR blocks and EKS subnet IDs", func() {
+		old := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{VPC: apisaws.VPC{CIDRBlocks: []string{"10.0.0.0/16"}}},
+			EKS:      &apisaws.EKS{SubnetIDs: []string{"subnet-1"}},
+		}
+		new := &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{VPC: apisaws.VPC{CIDRBlocks: []string{"10.0.0.0/16", "10.1.0.0/16"}}},
+			EKS:      &apisaws.EKS{SubnetIDs: []string{"subnet-1", "subnet-2"}},
+		}
+
+		Expect(ValidateInfrastructureConfigUpdate(new, old, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid dropping EKS.SubnetIDs by clearing the whole EKS section", func() {
+		old := &apisaws.InfrastructureConfig{
+			EKS: &apisaws.EKS{SubnetIDs: []string{"subnet-1", "subnet-2"}},
+		}
+		new := &apisaws.InfrastructureConfig{
+			EKS: nil,
+		}
+
+		Expect(ValidateInfrastructureConfigUpdate(new, old, fldPath)).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,143 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Resource kinds understood by the built-in AWS name validators registered below.
+const (
+	// KindS3Bucket identifies an S3 bucket name.
+	KindS3Bucket = "s3-bucket"
+	// KindIAMRole identifies an IAM role (or instance profile) name.
+	KindIAMRole = "iam-role"
+	// KindSecurityGroup identifies an EC2 security group name.
+	KindSecurityGroup = "security-group"
+	// KindEKSCluster identifies an EKS cluster name.
+	KindEKSCluster = "eks-cluster"
+	// KindELB identifies an ELB/NLB name.
+	KindELB = "elb"
+	// KindRoute53Zone identifies a Route53 hosted zone name.
+	KindRoute53Zone = "route53-zone"
+)
+
+// ValidateNameFunc validates a name and returns a list of validation error messages, mirroring
+// k8s.io/apimachinery/pkg/api/validation.ValidateNameFunc.
+type ValidateNameFunc func(name string, prefix bool) []string
+
+// nameValidators holds the registered ValidateNameFunc per AWS resource kind.
+var nameValidators = map[string]ValidateNameFunc{
+	KindS3Bucket:      validateS3BucketName,
+	KindIAMRole:       validateIAMRoleName,
+	KindSecurityGroup: validateSecurityGroupName,
+	KindEKSCluster:    validateEKSClusterName,
+	KindELB:           ValidateName,
+	KindRoute53Zone:   ValidateName,
+}
+
+// RegisterNameValidator registers fn as the ValidateNameFunc used for the given resource kind,
+// overriding any previously registered (including built-in) validator for that kind. This allows
+// downstream consumers to adapt AWS resource naming rules without forking this package.
+func RegisterNameValidator(kind string, fn ValidateNameFunc) {
+	nameValidators[kind] = fn
+}
+
+// nameValidatorForKind returns the ValidateNameFunc registered for kind, falling back to
+// ValidateName if no specific validator was registered.
+func nameValidatorForKind(kind string) ValidateNameFunc {
+	if fn, ok := nameValidators[kind]; ok {
+		return fn
+	}
+	return ValidateName
+}
+
+// s3BucketNameRegexp matches valid S3 bucket names: lowercase letters, digits, dots and hyphens,
+// starting and ending with a letter or digit.
+var s3BucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// validateS3BucketName validates S3 bucket names: 3-63 lowercase characters, no underscores.
+func validateS3BucketName(name string, _ bool) []string {
+	var errs []string
+	if len(name) < 3 || len(name) > 63 {
+		errs = append(errs, "must be between 3 and 63 characters long")
+	}
+	if !s3BucketNameRegexp.MatchString(name) {
+		errs = append(errs, "must consist of lowercase letters, digits, dots and hyphens, and start/end with a letter or digit")
+	}
+	return errs
+}
+
+// iamNameRegexp matches the characters AWS permits in IAM role and instance profile names.
+var iamNameRegexp = regexp.MustCompile(`^[\w+=,.@-]+$`)
+
+// validateIAMRoleName validates IAM role/instance profile names: up to 64 characters from the
+// AWS-permitted charset `+=,.@_-`.
+func validateIAMRoleName(name string, _ bool) []string {
+	var errs []string
+	if len(name) == 0 || len(name) > 64 {
+		errs = append(errs, "must be between 1 and 64 characters long")
+	}
+	if !iamNameRegexp.MatchString(name) {
+		errs = append(errs, fmt.Sprintf("must match the regex %q", iamNameRegexp.String()))
+	}
+	return errs
+}
+
+// securityGroupNameRegexp matches the characters AWS permits in EC2 security group names.
+var securityGroupNameRegexp = regexp.MustCompile(`^[\x20-\x7E]+$`)
+
+// validateSecurityGroupName validates EC2 security group names: up to 255 printable ASCII characters.
+func validateSecurityGroupName(name string, _ bool) []string {
+	var errs []string
+	if len(name) == 0 || len(name) > 255 {
+		errs = append(errs, "must be between 1 and 255 characters long")
+	}
+	if !securityGroupNameRegexp.MatchString(name) {
+		errs = append(errs, "must consist of printable ASCII characters")
+	}
+	return errs
+}
+
+// eksClusterNameRegexp matches valid EKS cluster names.
+var eksClusterNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_]*$`)
+
+// validateEKSClusterName validates EKS cluster names: `[A-Za-z][A-Za-z0-9\-_]*` up to 100 characters.
+func validateEKSClusterName(name string, _ bool) []string {
+	var errs []string
+	if len(name) == 0 || len(name) > 100 {
+		errs = append(errs, "must be between 1 and 100 characters long")
+	}
+	if !eksClusterNameRegexp.MatchString(name) {
+		errs = append(errs, fmt.Sprintf("must match the regex %q", eksClusterNameRegexp.String()))
+	}
+	return errs
+}
+
+// validateResourceName validates value as a name of the given AWS resource kind, dispatching to
+// the ValidateNameFunc returned by nameValidatorForKind. Kinds without a dedicated validator
+// (including the built-in ELB and Route53 zone kinds) are checked with the DNS-subdomain ValidateName.
+func validateResourceName(kind, value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, msg := range nameValidatorForKind(kind)(value, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, msg))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("names", func() {
+	Describe("#validateResourceName", func() {
+		DescribeTable("S3 bucket names",
+			func(name string, matcher gomegatypes.GomegaMatcher) {
+				Expect(validateResourceName(KindS3Bucket, name, field.NewPath("field"))).To(matcher)
+			},
+			Entry("valid", "my-shoot-state-bucket", BeEmpty()),
+			Entry("too short", "ab", Not(BeEmpty())),
+			Entry("contains underscore", "my_bucket", Not(BeEmpty())),
+		)
+
+		DescribeTable("IAM role names",
+			func(name string, matcher gomegatypes.GomegaMatcher) {
+				Expect(validateResourceName(KindIAMRole, name, field.NewPath("field"))).To(matcher)
+			},
+			Entry("valid", "my-shoot.worker+role=1@foo", BeEmpty()),
+			Entry("too long", stringOfLength(65), Not(BeEmpty())),
+			Entry("invalid character", "my role", Not(BeEmpty())),
+		)
+
+		DescribeTable("security group names",
+			func(name string, matcher gomegatypes.GomegaMatcher) {
+				Expect(validateResourceName(KindSecurityGroup, name, field.NewPath("field"))).To(matcher)
+			},
+			Entry("valid", "my-shoot-default-sg", BeEmpty()),
+			Entry("too long", stringOfLength(256), Not(BeEmpty())),
+		)
+
+		DescribeTable("EKS cluster names",
+			func(name string, matcher gomegatypes.GomegaMatcher) {
+				Expect(validateResourceName(KindEKSCluster, name, field.NewPath("field"))).To(matcher)
+			},
+			Entry("valid", "my-shoot", BeEmpty()),
+			Entry("starts with digit", "1cluster", Not(BeEmpty())),
+			Entry("contains invalid character", "my.cluster", Not(BeEmpty())),
+		)
+	})
+
+	Describe("#RegisterNameValidator", func() {
+		It("should allow overriding the validator for a kind", func() {
+			defer RegisterNameValidator(KindS3Bucket, nameValidators[KindS3Bucket])
+
+			RegisterNameValidator(KindS3Bucket, func(name string, _ bool) []string {
+				if name != "always-valid" {
+					return []string{"must be 'always-valid'"}
+				}
+				return nil
+			})
+
+			Expect(validateResourceName(KindS3Bucket, "always-valid", field.NewPath("field"))).To(BeEmpty())
+			Expect(validateResourceName(KindS3Bucket, "not-valid", field.NewPath("field"))).NotTo(BeEmpty())
+		})
+	})
+})
+
+func stringOfLength(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = 'a'
+	}
+	return string(b)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateName is a helper function for validating that a name is a DNS sub domain.
+func ValidateName(name string, prefix bool) []string {
+	return apivalidation.NameIsDNSSubdomain(name, prefix)
+}
+
+func validateNameConsecutiveHyphens(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strings.Contains(name, "--") {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "name may not contain two consecutive hyphens"))
+	}
+
+	return allErrs
+}
+
+// validateDNS1123Subdomain validates that a name is a proper DNS subdomain.
+func validateDNS1123Subdomain(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, msg := range validation.IsDNS1123Subdomain(value) {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, msg))
+	}
+
+	return allErrs
+}
+
+// validateWildcardDNS1123Subdomain validates that a name is a proper DNS subdomain, additionally
+// allowing a single leading wildcard label (e.g. "*.ingress.foo.example.com"). This is required
+// for AWS provider fields backed by Route53 hosted zones and ELB DNS hostnames, which are usually
+// plain hostnames but occasionally use a wildcard name. Mirrors the idiom used for Ingress TLS
+// hosts: only route through the wildcard-aware check when the value actually contains a "*".
+func validateWildcardDNS1123Subdomain(value string, fldPath *field.Path) field.ErrorList {
+	if !strings.Contains(value, "*") {
+		return validateDNS1123Subdomain(value, fldPath)
+	}
+
+	allErrs := field.ErrorList{}
+
+	for _, msg := range validation.IsWildcardDNS1123Subdomain(value) {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, msg))
+	}
+
+	return allErrs
+}
+
+// validateDNS1123Label valides a name is a proper RFC1123 DNS label.
+func validateDNS1123Label(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, msg := range validation.IsDNS1123Label(value) {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, msg))
+	}
+
+	return allErrs
+}
+
+func getIntOrPercentValue(intOrStringValue intstr.IntOrString) int {
+	value, isPercent := getPercentValue(intOrStringValue)
+	if isPercent {
+		return value
+	}
+	return intOrStringValue.IntValue()
+}
+
+func getPercentValue(intOrStringValue intstr.IntOrString) (int, bool) {
+	if intOrStringValue.Type != intstr.String {
+		return 0, false
+	}
+	if len(validation.IsValidPercent(intOrStringValue.StrVal)) != 0 {
+		return 0, false
+	}
+	value, _ := strconv.Atoi(intOrStringValue.StrVal[:len(intOrStringValue.StrVal)-1])
+	return value, true
+}
+
+// ShouldEnforceImmutability compares the given slices and returns if a immutability should be enforced.
+// Elements may be added to `new` and reordered freely; removing or mutating an element that was
+// already present in `old` still enforces immutability. It delegates to ShouldEnforceImmutabilityBy
+// with the identity function as key, i.e. each string is its own key.
+func ShouldEnforceImmutability(new, old []string) bool {
+	_, removed, mutated := ShouldEnforceImmutabilityBy(new, old, func(s string) string { return s })
+	return len(removed) > 0 || len(mutated) > 0
+}
+
+// ShouldEnforceImmutabilityBy compares the given slices of elements keyed by key and returns the
+// keys that were added, removed, or mutated between old and new. Unlike a plain order-sensitive
+// comparison, reordering elements is not reported at all, and added keys are returned separately
+// from removed and mutated ones, which are the only changes to elements already present in old.
+func ShouldEnforceImmutabilityBy[T any](new, old []T, key func(T) string) (added, removed, mutated []string) {
+	oldByKey := make(map[string]T, len(old))
+	for _, o := range old {
+		oldByKey[key(o)] = o
+	}
+	newByKey := make(map[string]T, len(new))
+	for _, n := range new {
+		newByKey[key(n)] = n
+	}
+
+	for k, o := range oldByKey {
+		n, ok := newByKey[k]
+		if !ok {
+			removed = append(removed, k)
+			continue
+		}
+		if !reflect.DeepEqual(o, n) {
+			mutated = append(mutated, k)
+		}
+	}
+	for k := range newByKey {
+		if _, ok := oldByKey[k]; !ok {
+			added = append(added, k)
+		}
+	}
+
+	return added, removed, mutated
+}
+
+// ValidateImmutableSet validates that new only adds elements relative to old, as determined by
+// ShouldEnforceImmutabilityBy. Removing or mutating an element that was already present in old is
+// reported as field.Forbidden at fldPath; adding new elements is always allowed.
+func ValidateImmutableSet[T any](new, old []T, key func(T) string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	_, removed, mutated := ShouldEnforceImmutabilityBy(new, old, key)
+	for _, k := range removed {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("element %q must not be removed", k)))
+	}
+	for _, k := range mutated {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("element %q must not be mutated", k)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("#validateWildcardDNS1123Subdomain", func() {
+	DescribeTable("should validate wildcard DNS1123 subdomains",
+		func(value string, matcher gomegatypes.GomegaMatcher) {
+			errs := validateWildcardDNS1123Subdomain(value, field.NewPath("field"))
+			Expect(errs).To(matcher)
+		},
+
+		Entry("wildcard subdomain", "*.foo.bar", BeEmpty()),
+		Entry("bare wildcard", "*", Not(BeEmpty())),
+		Entry("plain subdomain", "foo.bar", BeEmpty()),
+		Entry("plain ELB-style hostname", "my-elb-123.us-west-2.elb.amazonaws.com", BeEmpty()),
+		Entry("wildcard not in leading position", "foo.*.bar", Not(BeEmpty())),
+		Entry("empty value", "", Not(BeEmpty())),
+	)
+})
+
+var _ = Describe("#ShouldEnforceImmutabilityBy", func() {
+	It("should allow reordering elements without reporting them as mutated or removed", func() {
+		type zone struct{ name string }
+		old := []zone{{"a"}, {"b"}, {"c"}}
+		new := []zone{{"c"}, {"a"}, {"b"}}
+
+		added, removed, mutated := ShouldEnforceImmutabilityBy(new, old, func(z zone) string { return z.name })
+
+		Expect(added).To(BeEmpty())
+		Expect(removed).To(BeEmpty())
+		Expect(mutated).To(BeEmpty())
+	})
+
+	It("should allow adding new elements", func() {
+		type zone struct{ name string }
+		old := []zone{{"a"}, {"b"}}
+		new := []zone{{"a"}, {"b"}, {"c"}}
+
+		added, removed, mutated := ShouldEnforceImmutabilityBy(new, old, func(z zone) string { return z.name })
+
+		Expect(added).To(ConsistOf("c"))
+		Expect(removed).To(BeEmpty())
+		Expect(mutated).To(BeEmpty())
+	})
+
+	It("should report an element as mutated if its value changed while its key stayed the same", func() {
+		type natGateway struct {
+			zone string
+			ip   string
+		}
+		old := []natGateway{{"eu-west-1a", "1.1.1.1"}, {"eu-west-1b", "2.2.2.2"}}
+		new := []natGateway{{"eu-west-1a", "2.2.2.2"}, {"eu-west-1b", "1.1.1.1"}} // IPs swapped between zones
+
+		added, removed, mutated := ShouldEnforceImmutabilityBy(new, old, func(n natGateway) string { return n.zone })
+
+		Expect(added).To(BeEmpty())
+		Expect(removed).To(BeEmpty())
+		Expect(mutated).To(ConsistOf("eu-west-1a", "eu-west-1b"))
+	})
+
+	It("should report an element as removed if its key disappeared", func() {
+		type zone struct{ name string }
+		old := []zone{{"a"}, {"b"}}
+		new := []zone{{"a"}}
+
+		_, removed, _ := ShouldEnforceImmutabilityBy(new, old, func(z zone) string { return z.name })
+
+		Expect(removed).To(ConsistOf("b"))
+	})
+})
+
+var _ = Describe("#ValidateImmutableSet", func() {
+	It("should allow reordering elements and adding new ones", func() {
+		errs := ValidateImmutableSet([]string{"c", "a", "b", "d"}, []string{"a", "b", "c"}, func(s string) string { return s }, field.NewPath("field"))
+		Expect(errs).To(BeEmpty())
+	})
+
+	It("should forbid a NAT gateway IP swap between zones", func() {
+		type natGateway struct {
+			zone string
+			ip   string
+		}
+		old := []natGateway{{"eu-west-1a", "1.1.1.1"}, {"eu-west-1b", "2.2.2.2"}}
+		new := []natGateway{{"eu-west-1a", "2.2.2.2"}, {"eu-west-1b", "1.1.1.1"}}
+
+		errs := ValidateImmutableSet(new, old, func(n natGateway) string { return n.zone }, field.NewPath("field"))
+		Expect(errs).To(HaveLen(2))
+	})
+})
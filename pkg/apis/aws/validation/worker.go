@@ -0,0 +1,136 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object.
+func ValidateWorkerConfig(worker *apisaws.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ingressHostnamesPath := fldPath.Child("ingressHostnames")
+	for i, hostname := range worker.IngressHostnames {
+		allErrs = append(allErrs, validateWildcardDNS1123Subdomain(hostname, ingressHostnamesPath.Index(i))...)
+	}
+
+	if worker.IAMInstanceProfile != nil && worker.IAMInstanceProfile.Name != nil {
+		allErrs = append(allErrs, validateResourceName(KindIAMRole, *worker.IAMInstanceProfile.Name, fldPath.Child("iamInstanceProfile", "name"))...)
+	}
+
+	return allErrs
+}
+
+// WorkerPoolUpdatePolicy bundles the rolling-update relevant fields of a worker pool (normally
+// sourced from the core Worker API) together with this provider's optional per-zone
+// distribution from WorkerConfig.Zones, so callers can validate AWS-specific zone sums without
+// this package depending on the core Worker type.
+type WorkerPoolUpdatePolicy struct {
+	// Minimum is the pool-level minimum number of VMs.
+	Minimum int32
+	// Maximum is the pool-level maximum number of VMs.
+	Maximum int32
+	// MaxSurge is the pool-level maximum number of VMs that are surged during a rolling update.
+	MaxSurge *intstr.IntOrString
+	// MaxUnavailable is the pool-level maximum number of VMs that can be unavailable during a
+	// rolling update.
+	MaxUnavailable *intstr.IntOrString
+	// Zones is the optional per-zone distribution declared in WorkerConfig.Zones.
+	Zones []apisaws.WorkerPoolZone
+}
+
+// ValidateWorkerUpdatePolicy validates the rolling-update settings of a worker pool. It mirrors
+// the structure of the PodDisruptionBudget validation in k8s.io/kubernetes/pkg/apis/policy/validation:
+// percentages are resolved against the pool's Maximum and cross-checked against the absolute
+// per-zone distribution declared in WorkerConfig.Zones, if any.
+func ValidateWorkerUpdatePolicy(pool WorkerPoolUpdatePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maxSurge := intstr.FromInt(0)
+	if pool.MaxSurge != nil {
+		maxSurge = *pool.MaxSurge
+	}
+	maxUnavailable := intstr.FromInt(0)
+	if pool.MaxUnavailable != nil {
+		maxUnavailable = *pool.MaxUnavailable
+	}
+
+	if surgePercent, surgeIsPercent := getPercentValue(maxSurge); surgeIsPercent {
+		if unavailablePercent, unavailableIsPercent := getPercentValue(maxUnavailable); unavailableIsPercent && surgePercent+unavailablePercent > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("maxSurge: %s, maxUnavailable: %s", maxSurge.String(), maxUnavailable.String()), "the sum of maxSurge and maxUnavailable must not exceed 100%"))
+		}
+	}
+
+	resolvedSurge := resolveAgainst(maxSurge, pool.Maximum)
+	resolvedUnavailable := resolveAgainst(maxUnavailable, pool.Maximum)
+	if resolvedSurge == 0 && resolvedUnavailable == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "maxSurge and maxUnavailable must not both resolve to zero, as this would stall rollouts"))
+	}
+
+	if len(pool.Zones) > 0 {
+		zonesPath := fldPath.Child("zones")
+
+		var minSum, maxSum, surgeSum, unavailableSum int32
+		for i, zone := range pool.Zones {
+			zonePath := zonesPath.Index(i)
+			if zone.Minimum != nil {
+				minSum += *zone.Minimum
+			}
+			if zone.Maximum != nil {
+				maxSum += *zone.Maximum
+			}
+			if zone.MaxSurge != nil {
+				surgeSum += int32(resolveAgainst(*zone.MaxSurge, pool.Maximum))
+			}
+			if zone.MaxUnavailable != nil {
+				unavailableSum += int32(resolveAgainst(*zone.MaxUnavailable, pool.Maximum))
+			}
+			if zone.Minimum != nil && zone.Maximum != nil && *zone.Minimum > *zone.Maximum {
+				allErrs = append(allErrs, field.Invalid(zonePath.Child("minimum"), *zone.Minimum, "must not be greater than maximum"))
+			}
+		}
+
+		if minSum > pool.Minimum {
+			allErrs = append(allErrs, field.Invalid(zonesPath, minSum, fmt.Sprintf("sum of per-zone minimum must not exceed the pool's minimum (%d)", pool.Minimum)))
+		}
+		if maxSum > pool.Maximum {
+			allErrs = append(allErrs, field.Invalid(zonesPath, maxSum, fmt.Sprintf("sum of per-zone maximum must not exceed the pool's maximum (%d)", pool.Maximum)))
+		}
+		if surgeSum > int32(resolvedSurge) {
+			allErrs = append(allErrs, field.Invalid(zonesPath.Child("maxSurge"), surgeSum, fmt.Sprintf("sum of per-zone maxSurge must not exceed the pool's maxSurge (%d)", resolvedSurge)))
+		}
+		if unavailableSum > int32(resolvedUnavailable) {
+			allErrs = append(allErrs, field.Invalid(zonesPath.Child("maxUnavailable"), unavailableSum, fmt.Sprintf("sum of per-zone maxUnavailable must not exceed the pool's maxUnavailable (%d)", resolvedUnavailable)))
+		}
+	}
+
+	return allErrs
+}
+
+// resolveAgainst resolves intOrStringValue to an absolute value via the shared
+// getIntOrPercentValue helper, additionally scaling a percentage against total.
+func resolveAgainst(intOrStringValue intstr.IntOrString, total int32) int {
+	value := getIntOrPercentValue(intOrStringValue)
+	if _, isPercent := getPercentValue(intOrStringValue); isPercent {
+		return value * int(total) / 100
+	}
+	return value
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+func intOrStrPtr(v intstr.IntOrString) *intstr.IntOrString { return &v }
+func int32Ptr(v int32) *int32                              { return &v }
+
+var _ = Describe("#ValidateWorkerUpdatePolicy", func() {
+	var fldPath *field.Path
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("spec", "workers").Index(0)
+	})
+
+	It("should allow a plain absolute rolling update", func() {
+		pool := WorkerPoolUpdatePolicy{
+			Minimum:        1,
+			Maximum:        3,
+			MaxSurge:       intOrStrPtr(intstr.FromInt(1)),
+			MaxUnavailable: intOrStrPtr(intstr.FromInt(0)),
+		}
+
+		Expect(ValidateWorkerUpdatePolicy(pool, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid maxSurge and maxUnavailable percentages summing above 100%", func() {
+		pool := WorkerPoolUpdatePolicy{
+			Minimum:        1,
+			Maximum:        3,
+			MaxSurge:       intOrStrPtr(intstr.FromString("60%")),
+			MaxUnavailable: intOrStrPtr(intstr.FromString("50%")),
+		}
+
+		Expect(ValidateWorkerUpdatePolicy(pool, fldPath)).NotTo(BeEmpty())
+	})
+
+	It("should forbid maxSurge and maxUnavailable both resolving to zero", func() {
+		pool := WorkerPoolUpdatePolicy{
+			Minimum:        1,
+			Maximum:        3,
+			MaxSurge:       intOrStrPtr(intstr.FromInt(0)),
+			MaxUnavailable: intOrStrPtr(intstr.FromInt(0)),
+		}
+
+		Expect(ValidateWorkerUpdatePolicy(pool, fldPath)).NotTo(BeEmpty())
+	})
+
+	It("should allow per-zone distribution that sums up to the pool-level totals", func() {
+		pool := WorkerPoolUpdatePolicy{
+			Minimum:        2,
+			Maximum:        4,
+			MaxSurge:       intOrStrPtr(intstr.FromInt(2)),
+			MaxUnavailable: intOrStrPtr(intstr.FromInt(0)),
+			Zones: []apisaws.WorkerPoolZone{
+				{Name: "eu-west-1a", Minimum: int32Ptr(1), Maximum: int32Ptr(2), MaxSurge: intOrStrPtr(intstr.FromInt(1))},
+				{Name: "eu-west-1b", Minimum: int32Ptr(1), Maximum: int32Ptr(2), MaxSurge: intOrStrPtr(intstr.FromInt(1))},
+			},
+		}
+
+		Expect(ValidateWorkerUpdatePolicy(pool, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid a per-zone distribution exceeding the pool-level maximum", func() {
+		pool := WorkerPoolUpdatePolicy{
+			Minimum:        2,
+			Maximum:        4,
+			MaxSurge:       intOrStrPtr(intstr.FromInt(2)),
+			MaxUnavailable: intOrStrPtr(intstr.FromInt(0)),
+			Zones: []apisaws.WorkerPoolZone{
+				{Name: "eu-west-1a", Maximum: int32Ptr(3)},
+				{Name: "eu-west-1b", Maximum: int32Ptr(3)},
+			},
+		}
+
+		Expect(ValidateWorkerUpdatePolicy(pool, fldPath)).NotTo(BeEmpty())
+	})
+
+	It("should resolve percent-based maxSurge against the pool-level maximum for zone sums", func() {
+		pool := WorkerPoolUpdatePolicy{
+			Minimum:        0,
+			Maximum:        10,
+			MaxSurge:       intOrStrPtr(intstr.FromString("50%")),
+			MaxUnavailable: intOrStrPtr(intstr.FromInt(0)),
+			Zones: []apisaws.WorkerPoolZone{
+				{Name: "eu-west-1a", MaxSurge: intOrStrPtr(intstr.FromInt(3))},
+				{Name: "eu-west-1b", MaxSurge: intOrStrPtr(intstr.FromInt(3))},
+			},
+		}
+
+		// resolved pool maxSurge is 5 (50% of 10), zone sum is 6 -> forbidden
+		Expect(ValidateWorkerUpdatePolicy(pool, fldPath)).NotTo(BeEmpty())
+	})
+})